@@ -0,0 +1,69 @@
+package linodego
+
+/**
+ * Client wraps a resty.Client configured for the Linode API: base URL,
+ * bearer token, and (see retry.go) the default RetryPolicy.
+ */
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// APIHost is the default host used to build API endpoint URLs.
+const APIHost = "api.linode.com"
+
+// APIVersion is the default version of the API to use when building
+// endpoint URLs.
+const APIVersion = "v4"
+
+// defaultBaseURL is the base URL new Clients are configured with.
+const defaultBaseURL = "https://" + APIHost + "/" + APIVersion
+
+// Client is a Linode API client.
+type Client struct {
+	resty       *resty.Client
+	token       string
+	retryPolicy RetryPolicy
+}
+
+// NewClient creates a new Client, using hc as the underlying HTTP client if
+// non-nil, or resty's own default client otherwise.
+func NewClient(hc *http.Client) Client {
+	var r *resty.Client
+	if hc != nil {
+		r = resty.NewWithClient(hc)
+	} else {
+		r = resty.New()
+	}
+
+	r.SetBaseURL(defaultBaseURL)
+
+	c := Client{resty: r}
+	c.SetRetryPolicy(DefaultRetryPolicy)
+
+	return c
+}
+
+// SetToken sets the personal access (or OAuth) token used to authenticate
+// every request made by the Client.
+func (c *Client) SetToken(token string) *Client {
+	c.token = token
+	c.resty.SetHeader("Authorization", "Bearer "+token)
+
+	return c
+}
+
+// SetBaseURL overrides the default API host/version, e.g. for testing
+// against a mock server.
+func (c *Client) SetBaseURL(baseURL string) *Client {
+	c.resty.SetBaseURL(baseURL)
+	return c
+}
+
+// R returns a new resty.Request bound to ctx, ready for a single API call.
+func (c *Client) R(ctx context.Context) *resty.Request {
+	return c.resty.R().SetContext(ctx)
+}
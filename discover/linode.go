@@ -0,0 +1,154 @@
+// Package discover implements a github.com/hashicorp/go-discover Provider
+// backed by linodego, so tools like Consul and Nomad can auto-join a
+// cluster by looking up Linode instances that carry a given tag.
+package discover
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+
+	"github.com/linode/linodego"
+)
+
+// Provider implements the go-discover Provider interface
+// (Addrs(args map[string]string, l *log.Logger) ([]string, error) and
+// Help() string) on top of linodego.Client.
+type Provider struct {
+	// newClient builds the linodego.Client used to query the API. It is a
+	// field rather than a direct call so tests can substitute a fake.
+	newClient func(token string) *linodego.Client
+}
+
+// NewDiscoverProvider returns a go-discover Provider for Linode. Downstreams
+// register it without pulling in the rest of the linodego surface, importing
+// this package under an alias so it doesn't clash with go-discover's own
+// package name:
+//
+//	import linodediscover "github.com/linode/linodego/discover"
+//
+//	discover.WithProviders(map[string]discover.Provider{
+//		"linode": linodediscover.NewDiscoverProvider(),
+//	})
+func NewDiscoverProvider() *Provider {
+	return &Provider{newClient: defaultClient}
+}
+
+// Help describes the arguments Addrs accepts.
+func (p *Provider) Help() string {
+	return `Linode:
+
+    provider:   "linode"
+    tag_name:   The name of the tag to filter instances by. Required.
+    tag_value:  The value the tag must match. Required.
+    region:     The Linode region to restrict the search to. Optional.
+    addr_type:  "private_v4" (default) or "public_v6", selects which
+                address is returned for each matching instance.
+    token:      A Linode API token with read access to Linode instances.
+                Required.
+`
+}
+
+// Addrs looks up every Linode instance whose tags contain
+// "tag_name:tag_value" (optionally narrowed to a region), and returns one
+// address per match selected by addr_type.
+func (p *Provider) Addrs(args map[string]string, l *log.Logger) ([]string, error) {
+	tagName := args["tag_name"]
+	tagValue := args["tag_value"]
+	region := args["region"]
+	token := args["token"]
+	addrType := args["addr_type"]
+
+	if tagName == "" || tagValue == "" {
+		return nil, fmt.Errorf("discover-linode: tag_name and tag_value are required")
+	}
+	if token == "" {
+		return nil, fmt.Errorf("discover-linode: token is required")
+	}
+	if addrType == "" {
+		addrType = "private_v4"
+	}
+
+	client := p.newClient(token)
+
+	filter, err := tagFilter(tagName, tagValue, region)
+	if err != nil {
+		return nil, fmt.Errorf("discover-linode: %w", err)
+	}
+
+	ctx := context.Background()
+	it := client.NewInstanceIterator(ctx, &linodego.ListOptions{Filter: filter})
+
+	var addrs []string
+	for {
+		instance, err := it.Next()
+		if err == linodego.Done {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("discover-linode: listing instances: %w", err)
+		}
+
+		addr, ok := selectAddr(instance, addrType)
+		if !ok {
+			if l != nil {
+				l.Printf("[DEBUG] discover-linode: instance %d has no %s address, skipping", instance.ID, addrType)
+			}
+			continue
+		}
+
+		addrs = append(addrs, addr)
+	}
+
+	return addrs, nil
+}
+
+// tagFilter builds the X-Filter payload for ListInstances/Instances. Linode
+// tags are simple strings ("tag_name:tag_value" has no native meaning to
+// the API), so the filter matches on the combined "name:value" tag string.
+func tagFilter(tagName, tagValue, region string) (string, error) {
+	filter := map[string]any{"tags": fmt.Sprintf("%s:%s", tagName, tagValue)}
+	if region != "" {
+		filter["region"] = region
+	}
+
+	data, err := json.Marshal(filter)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// selectAddr returns the address of the requested type for instance, and
+// false if the instance has none.
+func selectAddr(instance *linodego.Instance, addrType string) (string, bool) {
+	switch addrType {
+	case "private_v4":
+		for _, ip := range instance.IPv4 {
+			if ip != nil && (*ip).IsPrivate() {
+				return (*ip).String(), true
+			}
+		}
+		return "", false
+	case "public_v6":
+		if instance.IPv6 == "" {
+			return "", false
+		}
+		host, _, err := net.SplitHostPort(instance.IPv6)
+		if err != nil {
+			return instance.IPv6, true
+		}
+		return host, true
+	default:
+		return "", false
+	}
+}
+
+func defaultClient(token string) *linodego.Client {
+	client := linodego.NewClient(nil)
+	client.SetToken(token)
+	return &client
+}
@@ -0,0 +1,114 @@
+package linodego
+
+/*
+ * https://developers.linode.com/v4/reference/endpoints/account/events
+ */
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const eventsEndpoint = "account/events"
+
+// EntityType is the kind of entity an Event's Entity refers to.
+type EntityType string
+
+const (
+	EntityLinode EntityType = "linode"
+	EntityDisk   EntityType = "disk"
+	EntityVolume EntityType = "volume"
+	EntityImage  EntityType = "image"
+)
+
+// EventAction describes what happened to trigger an Event.
+type EventAction string
+
+const (
+	ActionLinodeBoot     EventAction = "linode_boot"
+	ActionLinodeReboot   EventAction = "linode_reboot"
+	ActionLinodeShutdown EventAction = "linode_shutdown"
+	ActionLinodeCreate   EventAction = "linode_create"
+	ActionLinodeDelete   EventAction = "linode_delete"
+	ActionLinodeResize   EventAction = "linode_resize"
+	ActionLinodeRebuild  EventAction = "linode_rebuild"
+	ActionLinodeClone    EventAction = "linode_clone"
+	ActionLinodeMutate   EventAction = "linode_mutate"
+	ActionDiskImagize    EventAction = "disk_imagize"
+)
+
+// EventStatus is the lifecycle state of an Event.
+type EventStatus string
+
+const (
+	EventScheduled EventStatus = "scheduled"
+	EventStarted   EventStatus = "started"
+	EventFinished  EventStatus = "finished"
+	EventFailed    EventStatus = "failed"
+	EventNotified  EventStatus = "notification"
+)
+
+// EventEntity identifies the object an Event happened to.
+type EventEntity struct {
+	ID    int        `json:"id"`
+	Type  EntityType `json:"type"`
+	Label string     `json:"label"`
+	URL   string     `json:"url"`
+}
+
+// Event represents an action taken on the account, e.g. an instance boot or
+// an image capture finishing.
+type Event struct {
+	CreatedStr string `json:"created"`
+
+	ID      int
+	Created *time.Time `json:"-"`
+	Action  EventAction
+	Status  EventStatus
+	Entity  *EventEntity
+	Message string
+}
+
+func (e *Event) fixDates() *Event {
+	e.Created, _ = parseDates(e.CreatedStr)
+	return e
+}
+
+// EventsPagedResponse represents a Linode API response for listing events
+type EventsPagedResponse struct {
+	*PageOptions
+	Data []*Event
+}
+
+func (EventsPagedResponse) endpoint(_ ...any) string {
+	return eventsEndpoint
+}
+
+func (resp *EventsPagedResponse) castResult(req *resty.Request, e string) (*resty.Response, int, int, error) {
+	r, err := req.SetResult(&EventsPagedResponse{}).Get(e)
+	if err != nil {
+		return r, 0, 0, err
+	}
+
+	result := r.Result().(*EventsPagedResponse)
+	for _, event := range result.Data {
+		event.fixDates()
+	}
+
+	resp.Data = append(resp.Data, result.Data...)
+
+	return r, result.Pages, result.Results, nil
+}
+
+// ListEvents lists events on the account, most recent first. opts may be
+// nil to fetch every page.
+func (c *Client) ListEvents(ctx context.Context, opts *ListOptions, reqOpts ...RequestOption) ([]*Event, error) {
+	response := EventsPagedResponse{}
+	if err := c.listHelper(ctx, &response, opts, reqOpts); err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
@@ -0,0 +1,203 @@
+package linodego
+
+/**
+ * High-level orchestration for building a Linode Image from a (possibly
+ * throwaway) running instance, consolidating a workflow every
+ * Packer-style builder integrating with linodego otherwise reimplements.
+ */
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Provisioner customizes a throwaway (or existing) instance before it is
+// captured as an Image, e.g. running a StackScript-equivalent setup step
+// over SSH.
+type Provisioner func(ctx context.Context, instance *Instance) error
+
+// CaptureImageOptions configures Client.CaptureImage.
+type CaptureImageOptions struct {
+	// LinodeID captures an existing, already-running instance. Mutually
+	// exclusive with CreateOptions.
+	LinodeID int
+
+	// CreateOptions provisions a throwaway instance to capture from; it is
+	// torn down once the Image is available unless KeepOnFailure is set
+	// and capture fails. Mutually exclusive with LinodeID.
+	CreateOptions *InstanceCreateOptions
+
+	// DiskID pins which disk to capture. If zero, the largest ext4 disk on
+	// the instance is used.
+	DiskID int
+
+	// Label and Description are applied to the resulting Image.
+	Label       string
+	Description string
+	// Tags are applied to the resulting Image.
+	Tags []string
+
+	// Provision runs against the instance once it's reached InstanceRunning,
+	// before it is shut down for capture. Optional.
+	Provision Provisioner
+
+	// KeepOnFailure leaves a throwaway instance (and any intermediate
+	// state) in place if capture fails, so it can be inspected instead of
+	// torn down.
+	KeepOnFailure bool
+
+	// Timeout bounds each individual wait (boot, shutdown, image
+	// availability). Defaults to 10 minutes.
+	Timeout time.Duration
+}
+
+// CaptureImage provisions (or reuses) a running instance, optionally runs a
+// Provisioner hook against it, shuts it down, and captures one of its disks
+// as a new Image:
+//
+//	instance running -> Provision -> shutdown -> POST /images -> available
+//
+// A throwaway instance created via CreateOptions is torn down once capture
+// finishes, unless it fails and KeepOnFailure is set.
+func (c *Client) CaptureImage(ctx context.Context, opts CaptureImageOptions) (*Image, error) {
+	if (opts.LinodeID == 0) == (opts.CreateOptions == nil) {
+		return nil, fmt.Errorf("linodego: CaptureImage requires exactly one of LinodeID or CreateOptions")
+	}
+
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = 10 * time.Minute
+	}
+
+	instance, throwaway, err := c.captureSource(ctx, opts, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	image, err := c.runCapture(ctx, instance, opts, timeout)
+	if err != nil {
+		if throwaway && !opts.KeepOnFailure {
+			_, _ = c.DeleteInstance(ctx, instance.ID)
+		}
+
+		return nil, err
+	}
+
+	if throwaway {
+		if _, err := c.DeleteInstance(ctx, instance.ID); err != nil {
+			return image, fmt.Errorf("linodego: image %s captured but failed to tear down throwaway instance %d: %w", image.ID, instance.ID, err)
+		}
+	}
+
+	return image, nil
+}
+
+// captureSource resolves the instance to capture from, provisioning a
+// throwaway one and waiting for it to come up if CreateOptions was given.
+// Either way, the instance is not handed off for provisioning/shutdown until
+// it has reached InstanceRunning: an existing instance passed via LinodeID
+// is just as capable of still being mid-boot or mid-resize as a freshly
+// created one.
+func (c *Client) captureSource(ctx context.Context, opts CaptureImageOptions, timeout time.Duration) (instance *Instance, throwaway bool, err error) {
+	if opts.LinodeID != 0 {
+		instance, err = c.GetInstance(ctx, opts.LinodeID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		instance, err = c.WaitForInstanceStatus(ctx, instance.ID, InstanceRunning, timeout)
+		if err != nil {
+			return nil, false, fmt.Errorf("linodego: waiting for instance %d to reach running: %w", opts.LinodeID, err)
+		}
+
+		return instance, false, nil
+	}
+
+	created, err := c.CreateInstance(ctx, *opts.CreateOptions)
+	if err != nil {
+		return nil, false, fmt.Errorf("linodego: creating throwaway instance: %w", err)
+	}
+
+	instance, err = c.WaitForInstanceStatus(ctx, created.ID, InstanceRunning, timeout)
+	if err != nil {
+		if !opts.KeepOnFailure {
+			_, _ = c.DeleteInstance(ctx, created.ID)
+		}
+
+		return nil, true, fmt.Errorf("linodego: waiting for throwaway instance to start: %w", err)
+	}
+
+	return instance, true, nil
+}
+
+// runCapture provisions, shuts down, and captures instance once it is
+// resolved. It does not tear the instance down; the caller owns that.
+func (c *Client) runCapture(ctx context.Context, instance *Instance, opts CaptureImageOptions, timeout time.Duration) (*Image, error) {
+	if opts.Provision != nil {
+		if err := opts.Provision(ctx, instance); err != nil {
+			return nil, fmt.Errorf("linodego: provisioning instance %d: %w", instance.ID, err)
+		}
+	}
+
+	shutdownAt := time.Now()
+	if _, err := c.ShutdownInstance(ctx, instance.ID); err != nil {
+		return nil, fmt.Errorf("linodego: shutting down instance %d: %w", instance.ID, err)
+	}
+
+	if _, err := c.WaitForEventFinished(ctx, instance.ID, EntityLinode, ActionLinodeShutdown, shutdownAt, timeout); err != nil {
+		return nil, fmt.Errorf("linodego: waiting for instance %d to shut down: %w", instance.ID, err)
+	}
+
+	diskID := opts.DiskID
+	if diskID == 0 {
+		disk, err := c.largestExt4Disk(ctx, instance.ID)
+		if err != nil {
+			return nil, err
+		}
+		diskID = disk.ID
+	}
+
+	image, err := c.CreateImage(ctx, ImageCreateOptions{
+		DiskID:      diskID,
+		Label:       opts.Label,
+		Description: opts.Description,
+		Tags:        opts.Tags,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("linodego: creating image from disk %d: %w", diskID, err)
+	}
+
+	available, err := c.WaitForImageStatus(ctx, image.ID, ImageAvailable, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("linodego: waiting for image %s to become available: %w", image.ID, err)
+	}
+
+	return available, nil
+}
+
+// largestExt4Disk returns the biggest ext4 disk attached to the instance,
+// the default capture target when CaptureImageOptions.DiskID is unset.
+func (c *Client) largestExt4Disk(ctx context.Context, linodeID int) (*InstanceDisk, error) {
+	disks, err := c.ListInstanceDisks(ctx, linodeID, &ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("linodego: listing disks on instance %d: %w", linodeID, err)
+	}
+
+	var largest *InstanceDisk
+	for _, disk := range disks {
+		if disk.Filesystem != "ext4" {
+			continue
+		}
+		if largest == nil || disk.Size > largest.Size {
+			disk := disk
+			largest = disk
+		}
+	}
+
+	if largest == nil {
+		return nil, fmt.Errorf("linodego: instance %d has no ext4 disk to capture", linodeID)
+	}
+
+	return largest, nil
+}
@@ -0,0 +1,159 @@
+package linodego
+
+/*
+ * https://developers.linode.com/v4/reference/endpoints/images
+ */
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const imagesEndpoint = "images"
+
+// ImageStatus is the lifecycle state of an Image.
+type ImageStatus string
+
+const (
+	ImageCreating  ImageStatus = "creating"
+	ImagePending   ImageStatus = "pending_upload"
+	ImageAvailable ImageStatus = "available"
+)
+
+// Image represents a Linode image, either a public distribution or one
+// captured from an instance's disk.
+type Image struct {
+	CreatedStr string `json:"created"`
+
+	ID          string
+	Created     *time.Time `json:"-"`
+	Label       string
+	Description string
+	Status      ImageStatus
+	Size        int
+	Tags        []string
+}
+
+func (i *Image) fixDates() *Image {
+	i.Created, _ = parseDates(i.CreatedStr)
+	return i
+}
+
+// ImageCreateOptions is the set of options permitted to create an Image
+// from an existing disk.
+type ImageCreateOptions struct {
+	DiskID      int      `json:"disk_id"`
+	Label       string   `json:"label,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+}
+
+// CreateImage captures an Image from an existing disk. reqOpts may carry a
+// WithRetryPolicy override for this call.
+func (c *Client) CreateImage(ctx context.Context, opts ImageCreateOptions, reqOpts ...RequestOption) (*Image, error) {
+	body, err := json.Marshal(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := c.resolveRetryPolicy(reqOpts)
+
+	r, err := c.doWithRetry(ctx, policy, func() (*resty.Response, error) {
+		return c.R(ctx).
+			SetResult(&Image{}).
+			SetHeader("Content-Type", "application/json").
+			SetBody(string(body)).
+			Post(imagesEndpoint)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*Image).fixDates(), nil
+}
+
+// GetImage gets a single Image by ID. reqOpts may carry a WithRetryPolicy
+// override for this call.
+func (c *Client) GetImage(ctx context.Context, imageID string, reqOpts ...RequestOption) (*Image, error) {
+	e := fmt.Sprintf("%s/%s", imagesEndpoint, imageID)
+	policy := c.resolveRetryPolicy(reqOpts)
+
+	r, err := c.doWithRetry(ctx, policy, func() (*resty.Response, error) {
+		return c.R(ctx).SetResult(&Image{}).Get(e)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*Image).fixDates(), nil
+}
+
+// ImagesPagedResponse represents a Linode API response for listing images.
+type ImagesPagedResponse struct {
+	*PageOptions
+	Data []*Image
+}
+
+func (ImagesPagedResponse) endpoint(_ ...any) string {
+	return imagesEndpoint
+}
+
+func (resp *ImagesPagedResponse) castResult(req *resty.Request, e string) (*resty.Response, int, int, error) {
+	r, err := req.SetResult(&ImagesPagedResponse{}).Get(e)
+	if err != nil {
+		return r, 0, 0, err
+	}
+
+	result := r.Result().(*ImagesPagedResponse)
+	for _, image := range result.Data {
+		image.fixDates()
+	}
+
+	resp.Data = append(resp.Data, result.Data...)
+
+	return r, result.Pages, result.Results, nil
+}
+
+// ListImages lists images visible to the account. opts may be nil to fetch
+// every page.
+func (c *Client) ListImages(ctx context.Context, opts *ListOptions, reqOpts ...RequestOption) ([]*Image, error) {
+	response := ImagesPagedResponse{}
+	if err := c.listHelper(ctx, &response, opts, reqOpts); err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// WaitForImageStatus polls the image until it reaches target, timeout
+// elapses, or ctx is done.
+func (c *Client) WaitForImageStatus(ctx context.Context, imageID string, target ImageStatus, timeout time.Duration) (*Image, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	backoff := newPollBackoff()
+
+	for {
+		image, err := c.GetImage(ctx, imageID)
+		if err != nil {
+			return nil, err
+		}
+
+		if image.Status == target {
+			return image, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, WaitForTimeoutError{
+				Condition: fmt.Sprintf("image %s to reach status %s (currently %s)", imageID, target, image.Status),
+				LastError: ctx.Err(),
+			}
+		case <-time.After(backoff.next()):
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package linodego
+
+/*
+ * https://developers.linode.com/v4/reference/endpoints/linode/instances/disks
+ */
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// DiskStatus is the lifecycle state of an InstanceDisk.
+type DiskStatus string
+
+const (
+	DiskReady    DiskStatus = "ready"
+	DiskNotReady DiskStatus = "not_ready"
+	DiskDeleting DiskStatus = "deleting"
+)
+
+// InstanceDisk represents a disk attached to a Linode instance.
+type InstanceDisk struct {
+	ID         int
+	Label      string
+	Status     DiskStatus
+	Size       int
+	Filesystem string
+}
+
+// InstanceDisksPagedResponse represents a Linode API response for listing
+// the disks attached to an instance.
+type InstanceDisksPagedResponse struct {
+	*PageOptions
+	Data []*InstanceDisk
+}
+
+func (InstanceDisksPagedResponse) endpoint(ids ...any) string {
+	return fmt.Sprintf("%s/%d/disks", instancesEndpoint, ids[0])
+}
+
+func (resp *InstanceDisksPagedResponse) castResult(req *resty.Request, e string) (*resty.Response, int, int, error) {
+	r, err := req.SetResult(&InstanceDisksPagedResponse{}).Get(e)
+	if err != nil {
+		return r, 0, 0, err
+	}
+
+	result := r.Result().(*InstanceDisksPagedResponse)
+	resp.Data = append(resp.Data, result.Data...)
+
+	return r, result.Pages, result.Results, nil
+}
+
+// ListInstanceDisks lists the disks attached to the given instance. opts
+// may be nil to fetch every page.
+func (c *Client) ListInstanceDisks(ctx context.Context, linodeID int, opts *ListOptions, reqOpts ...RequestOption) ([]*InstanceDisk, error) {
+	response := InstanceDisksPagedResponse{}
+	if err := c.listHelper(ctx, &response, opts, reqOpts, linodeID); err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// GetInstanceDisk gets a single disk attached to the given instance.
+// reqOpts may carry a WithRetryPolicy override for this call.
+func (c *Client) GetInstanceDisk(ctx context.Context, linodeID, diskID int, reqOpts ...RequestOption) (*InstanceDisk, error) {
+	e := fmt.Sprintf("%s/%d/disks/%d", instancesEndpoint, linodeID, diskID)
+	policy := c.resolveRetryPolicy(reqOpts)
+
+	r, err := c.doWithRetry(ctx, policy, func() (*resty.Response, error) {
+		return c.R(ctx).SetResult(&InstanceDisk{}).Get(e)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.Result().(*InstanceDisk), nil
+}
@@ -1,18 +1,20 @@
-package golinode
+package linodego
+
+/*
+ * https://developers.linode.com/v4/reference/endpoints/linode/instances
+ */
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net"
-	"strconv"
 	"time"
 
-	"github.com/go-resty/resty"
+	"github.com/go-resty/resty/v2"
 )
 
-/*
- * https://developers.linode.com/v4/reference/endpoints/linode/instances
- */
+const instancesEndpoint = "linode/instances"
 
 // Instance represents a linode object
 type Instance struct {
@@ -80,14 +82,30 @@ type InstanceCreateOptions struct {
 
 // InstanceCloneOptions is an options struct when sending a clone request to the API
 type InstanceCloneOptions struct {
-	Region         string
-	Type           string
-	LinodeID       int
-	Label          string
-	Group          string
-	BackupsEnabled bool
-	Disks          []string
-	Configs        []string
+	Region         string   `json:"region,omitempty"`
+	Type           string   `json:"type,omitempty"`
+	LinodeID       int      `json:"linode_id,omitempty"`
+	Label          string   `json:"label,omitempty"`
+	Group          string   `json:"group,omitempty"`
+	BackupsEnabled bool     `json:"backups_enabled,omitempty"`
+	Disks          []string `json:"disks,omitempty"`
+	Configs        []string `json:"configs,omitempty"`
+}
+
+// parseDates parses the plain (timezone-less, UTC) timestamps the API
+// returns, e.g. "2018-01-01T00:01:01". An empty string is not an error; it
+// simply has no date.
+func parseDates(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse("2006-01-02T15:04:05", s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse date %q: %w", s, err)
+	}
+
+	return &t, nil
 }
 
 func (l *Instance) fixDates() *Instance {
@@ -97,105 +115,79 @@ func (l *Instance) fixDates() *Instance {
 }
 
 // InstancesPagedResponse represents a linode API response for listing
+// instances
 type InstancesPagedResponse struct {
 	*PageOptions
 	Data []*Instance
 }
 
-// Endpoint gets the endpoint URL for Instance
-func (InstancesPagedResponse) Endpoint(c *Client) string {
-	endpoint, err := c.Instances.Endpoint()
-	if err != nil {
-		panic(err)
-	}
-	return endpoint
-}
-
-// AppendData appends Instances when processing paginated Instance responses
-func (resp *InstancesPagedResponse) AppendData(r *InstancesPagedResponse) {
-	(*resp).Data = append(resp.Data, r.Data...)
+// endpoint returns the endpoint URL for Instances; it takes no ids since
+// instances are a top-level resource.
+func (InstancesPagedResponse) endpoint(_ ...any) string {
+	return instancesEndpoint
 }
 
-// SetResult sets the Resty response type of Instance
-func (InstancesPagedResponse) SetResult(r *resty.Request) {
-	r.SetResult(InstancesPagedResponse{})
-}
-
-// ListInstances lists linode instances
-func (c *Client) ListInstances(opts *ListOptions) ([]*Instance, error) {
-	e, err := c.Instances.Endpoint()
+// castResult fetches a single page via req and appends its data into resp,
+// satisfying the PagedResponse interface listHelper requires.
+func (resp *InstancesPagedResponse) castResult(req *resty.Request, e string) (*resty.Response, int, int, error) {
+	r, err := req.SetResult(&InstancesPagedResponse{}).Get(e)
 	if err != nil {
-		return nil, err
+		return r, 0, 0, err
 	}
 
-	req := c.R().SetResult(&InstancesPagedResponse{})
-
-	if opts != nil {
-		req.SetQueryParam("page", strconv.Itoa(opts.Page))
-	}
-
-	r, err := req.Get(e)
-	if err != nil {
-		return nil, err
+	result := r.Result().(*InstancesPagedResponse)
+	for _, instance := range result.Data {
+		instance.fixDates()
 	}
 
-	data := r.Result().(*InstancesPagedResponse).Data
-	pages := r.Result().(*InstancesPagedResponse).Pages
-	results := r.Result().(*InstancesPagedResponse).Results
+	resp.Data = append(resp.Data, result.Data...)
 
-	for _, el := range data {
-		el.fixDates()
-	}
+	return r, result.Pages, result.Results, nil
+}
 
-	if opts == nil {
-		for page := 2; page <= pages; page = page + 1 {
-			next, _ := c.ListInstances(&ListOptions{PageOptions: &PageOptions{Page: page}})
-			data = append(data, next...)
-		}
-	} else {
-		opts.Results = results
+// ListInstances lists Linode instances. opts may be nil to fetch every page.
+func (c *Client) ListInstances(ctx context.Context, opts *ListOptions, reqOpts ...RequestOption) ([]*Instance, error) {
+	response := InstancesPagedResponse{}
+	if err := c.listHelper(ctx, &response, opts, reqOpts); err != nil {
+		return nil, err
 	}
 
-	return data, nil
+	return response.Data, nil
 }
 
-// GetInstance gets the instance with the provided ID
-func (c *Client) GetInstance(linodeID int) (*Instance, error) {
-	e, err := c.Instances.Endpoint()
-	if err != nil {
-		return nil, err
-	}
-	e = fmt.Sprintf("%s/%d", e, linodeID)
-	r, err := c.R().
-		SetResult(&Instance{}).
-		Get(e)
+// GetInstance gets the instance with the provided ID. reqOpts may carry a
+// WithRetryPolicy override for this call.
+func (c *Client) GetInstance(ctx context.Context, linodeID int, reqOpts ...RequestOption) (*Instance, error) {
+	e := fmt.Sprintf("%s/%d", instancesEndpoint, linodeID)
+	policy := c.resolveRetryPolicy(reqOpts)
+
+	r, err := c.doWithRetry(ctx, policy, func() (*resty.Response, error) {
+		return c.R(ctx).SetResult(&Instance{}).Get(e)
+	})
 	if err != nil {
 		return nil, err
 	}
+
 	return r.Result().(*Instance).fixDates(), nil
 }
 
-// CreateInstance creates a Linode instance
-func (c *Client) CreateInstance(instance *InstanceCreateOptions) (*Instance, error) {
-	var body string
-	e, err := c.Instances.Endpoint()
+// CreateInstance creates a Linode instance. reqOpts may carry a
+// WithRetryPolicy override for this call.
+func (c *Client) CreateInstance(ctx context.Context, opts InstanceCreateOptions, reqOpts ...RequestOption) (*Instance, error) {
+	body, err := json.Marshal(opts)
 	if err != nil {
 		return nil, err
 	}
 
-	req := c.R().SetResult(&Instance{})
-
-	if bodyData, err := json.Marshal(instance); err == nil {
-		body = string(bodyData)
-	} else {
-		return nil, err
-	}
-
-	r, err := req.
-		SetHeader("Content-Type", "application/json").
-		SetBody(body).
-		Post(e)
+	policy := c.resolveRetryPolicy(reqOpts)
 
+	r, err := c.doWithRetry(ctx, policy, func() (*resty.Response, error) {
+		return c.R(ctx).
+			SetResult(&Instance{}).
+			SetHeader("Content-Type", "application/json").
+			SetBody(string(body)).
+			Post(instancesEndpoint)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -203,55 +195,63 @@ func (c *Client) CreateInstance(instance *InstanceCreateOptions) (*Instance, err
 	return r.Result().(*Instance).fixDates(), nil
 }
 
-// BootInstance will boot a new linode instance
-func (c *Client) BootInstance(id int, configID int) (bool, error) {
+// DeleteInstance deletes the instance with the provided ID. reqOpts may
+// carry a WithRetryPolicy override for this call.
+func (c *Client) DeleteInstance(ctx context.Context, linodeID int, reqOpts ...RequestOption) (bool, error) {
+	e := fmt.Sprintf("%s/%d", instancesEndpoint, linodeID)
+	policy := c.resolveRetryPolicy(reqOpts)
+
+	r, err := c.doWithRetry(ctx, policy, func() (*resty.Response, error) {
+		return c.R(ctx).Delete(e)
+	})
+
+	return settleBoolResponseOrError(r, err)
+}
+
+// BootInstance will boot a new linode instance. reqOpts may carry a
+// WithRetryPolicy override for this call.
+func (c *Client) BootInstance(ctx context.Context, id, configID int, reqOpts ...RequestOption) (bool, error) {
 	bodyStr := ""
 
 	if configID != 0 {
-		bodyMap := map[string]string{"config_id": string(configID)}
-		bodyJSON, err := json.Marshal(bodyMap)
+		bodyJSON, err := json.Marshal(map[string]int{"config_id": configID})
 		if err != nil {
 			return false, err
 		}
 		bodyStr = string(bodyJSON)
 	}
 
-	e, err := c.Instances.Endpoint()
-	if err != nil {
-		return false, err
-	}
+	e := fmt.Sprintf("%s/%d/boot", instancesEndpoint, id)
+	policy := c.resolveRetryPolicy(reqOpts)
 
-	e = fmt.Sprintf("%s/%d/boot", e, id)
-	r, err := c.R().
-		SetHeader("Content-Type", "application/json").
-		SetBody(bodyStr).
-		Post(e)
+	r, err := c.doWithRetry(ctx, policy, func() (*resty.Response, error) {
+		return c.R(ctx).
+			SetHeader("Content-Type", "application/json").
+			SetBody(bodyStr).
+			Post(e)
+	})
 
 	return settleBoolResponseOrError(r, err)
 }
 
-// CloneInstance clones a Linode instance
-func (c *Client) CloneInstance(id int, options *InstanceCloneOptions) (*Instance, error) {
-	var body string
-	e, err := c.Instances.Endpoint()
+// CloneInstance clones a Linode instance. reqOpts may carry a
+// WithRetryPolicy override for this call.
+func (c *Client) CloneInstance(ctx context.Context, id int, options InstanceCloneOptions, reqOpts ...RequestOption) (*Instance, error) {
+	body, err := json.Marshal(options)
 	if err != nil {
 		return nil, err
 	}
-	e = fmt.Sprintf("%s/%d/clone", e, id)
 
-	req := c.R().SetResult(&Instance{})
-
-	if bodyData, err := json.Marshal(options); err == nil {
-		body = string(bodyData)
-	} else {
-		return nil, err
-	}
-
-	r, err := req.
-		SetHeader("Content-Type", "application/json").
-		SetBody(body).
-		Post(e)
+	e := fmt.Sprintf("%s/%d/clone", instancesEndpoint, id)
+	policy := c.resolveRetryPolicy(reqOpts)
 
+	r, err := c.doWithRetry(ctx, policy, func() (*resty.Response, error) {
+		return c.R(ctx).
+			SetResult(&Instance{}).
+			SetHeader("Content-Type", "application/json").
+			SetBody(string(body)).
+			Post(e)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -259,116 +259,124 @@ func (c *Client) CloneInstance(id int, options *InstanceCloneOptions) (*Instance
 	return r.Result().(*Instance).fixDates(), nil
 }
 
-// RebootInstance reboots a Linode instance
-func (c *Client) RebootInstance(id int, configID int) (bool, error) {
-	body := fmt.Sprintf("{\"config_id\":\"%d\"}", configID)
+// RebootInstance reboots a Linode instance. reqOpts may carry a
+// WithRetryPolicy override for this call.
+func (c *Client) RebootInstance(ctx context.Context, id, configID int, reqOpts ...RequestOption) (bool, error) {
+	body := fmt.Sprintf(`{"config_id":%d}`, configID)
 
-	e, err := c.Instances.Endpoint()
-	if err != nil {
-		return false, err
-	}
-
-	e = fmt.Sprintf("%s/%d/reboot", e, id)
+	e := fmt.Sprintf("%s/%d/reboot", instancesEndpoint, id)
+	policy := c.resolveRetryPolicy(reqOpts)
 
-	r, err := c.R().
-		SetHeader("Content-Type", "application/json").
-		SetBody(body).
-		Post(e)
+	r, err := c.doWithRetry(ctx, policy, func() (*resty.Response, error) {
+		return c.R(ctx).
+			SetHeader("Content-Type", "application/json").
+			SetBody(body).
+			Post(e)
+	})
 
 	return settleBoolResponseOrError(r, err)
 }
 
-// MutateInstance Upgrades a Linode to its next generation.
-func (c *Client) MutateInstance(id int) (bool, error) {
-	e, err := c.Instances.Endpoint()
-	if err != nil {
-		return false, err
-	}
-	e = fmt.Sprintf("%s/%d/mutate", e, id)
+// MutateInstance Upgrades a Linode to its next generation. reqOpts may
+// carry a WithRetryPolicy override for this call.
+func (c *Client) MutateInstance(ctx context.Context, id int, reqOpts ...RequestOption) (bool, error) {
+	e := fmt.Sprintf("%s/%d/mutate", instancesEndpoint, id)
+	policy := c.resolveRetryPolicy(reqOpts)
+
+	r, err := c.doWithRetry(ctx, policy, func() (*resty.Response, error) {
+		return c.R(ctx).Post(e)
+	})
 
-	r, err := c.R().Post(e)
 	return settleBoolResponseOrError(r, err)
 }
 
 // RebuildInstanceOptions is a struct representing the options to send to the rebuild linode endpoint
 type RebuildInstanceOptions struct {
-	Image           string
-	RootPass        string
-	AuthorizedKeys  []string
-	StackscriptID   int
-	StackscriptData map[string]string
-	Booted          bool
+	Image           string            `json:"image,omitempty"`
+	RootPass        string            `json:"root_pass,omitempty"`
+	AuthorizedKeys  []string          `json:"authorized_keys,omitempty"`
+	StackscriptID   int               `json:"stackscript_id,omitempty"`
+	StackscriptData map[string]string `json:"stackscript_data,omitempty"`
+	Booted          bool              `json:"booted,omitempty"`
 }
 
 // RebuildInstance Deletes all Disks and Configs on this Linode,
 // then deploys a new Image to this Linode with the given attributes.
-func (c *Client) RebuildInstance(id int, opts *RebuildInstanceOptions) (*Instance, error) {
-	o, err := json.Marshal(opts)
-	if err != nil {
-		return nil, err
-	}
-	b := string(o)
-	e, err := c.Instances.Endpoint()
+// reqOpts may carry a WithRetryPolicy override for this call.
+func (c *Client) RebuildInstance(ctx context.Context, id int, opts RebuildInstanceOptions, reqOpts ...RequestOption) (*Instance, error) {
+	body, err := json.Marshal(opts)
 	if err != nil {
 		return nil, err
 	}
-	e = fmt.Sprintf("%s/%d/rebuild", e, id)
-	r, err := c.R().
-		SetHeader("Content-Type", "application/json").
-		SetBody(b).
-		SetResult(&Instance{}).
-		Post(e)
+
+	e := fmt.Sprintf("%s/%d/rebuild", instancesEndpoint, id)
+	policy := c.resolveRetryPolicy(reqOpts)
+
+	r, err := c.doWithRetry(ctx, policy, func() (*resty.Response, error) {
+		return c.R(ctx).
+			SetHeader("Content-Type", "application/json").
+			SetBody(string(body)).
+			SetResult(&Instance{}).
+			Post(e)
+	})
 	if err != nil {
 		return nil, err
 	}
+
 	return r.Result().(*Instance).fixDates(), nil
 }
 
-// ResizeInstance resizes an instance to new Linode type
-func (c *Client) ResizeInstance(id int, linodeType string) (bool, error) {
-	body := fmt.Sprintf("{\"type\":\"%s\"}", linodeType)
+// ResizeInstance resizes an instance to new Linode type. reqOpts may
+// carry a WithRetryPolicy override for this call.
+func (c *Client) ResizeInstance(ctx context.Context, id int, linodeType string, reqOpts ...RequestOption) (bool, error) {
+	body := fmt.Sprintf(`{"type":%q}`, linodeType)
 
-	e, err := c.Instances.Endpoint()
-	if err != nil {
-		return false, err
-	}
-	e = fmt.Sprintf("%s/%d/resize", e, id)
+	e := fmt.Sprintf("%s/%d/resize", instancesEndpoint, id)
+	policy := c.resolveRetryPolicy(reqOpts)
 
-	r, err := c.R().
-		SetHeader("Content-Type", "application/json").
-		SetBody(body).
-		Post(e)
+	r, err := c.doWithRetry(ctx, policy, func() (*resty.Response, error) {
+		return c.R(ctx).
+			SetHeader("Content-Type", "application/json").
+			SetBody(body).
+			Post(e)
+	})
 
 	return settleBoolResponseOrError(r, err)
 }
 
-// ShutdownInstance - Shutdown an instance
-func (c *Client) ShutdownInstance(id int) (bool, error) {
-	e, err := c.Instances.Endpoint()
-	if err != nil {
-		return false, err
-	}
-	e = fmt.Sprintf("%s/%d/resize", e, id)
-	return settleBoolResponseOrError(c.R().Post(e))
+// ShutdownInstance shuts down an instance. reqOpts may carry a
+// WithRetryPolicy override for this call.
+func (c *Client) ShutdownInstance(ctx context.Context, id int, reqOpts ...RequestOption) (bool, error) {
+	e := fmt.Sprintf("%s/%d/shutdown", instancesEndpoint, id)
+	policy := c.resolveRetryPolicy(reqOpts)
+
+	r, err := c.doWithRetry(ctx, policy, func() (*resty.Response, error) {
+		return c.R(ctx).Post(e)
+	})
+
+	return settleBoolResponseOrError(r, err)
 }
 
-// ListInstanceVolumes lists volumes attached to a linode instance
-func (c *Client) ListInstanceVolumes(id int) ([]*Volume, error) {
-	e, err := c.Instances.Endpoint()
-	e = fmt.Sprintf("%s/%d/volumes", e, id)
+// ListInstanceVolumes lists volumes attached to a linode instance. reqOpts
+// may carry a WithRetryPolicy override for this call.
+func (c *Client) ListInstanceVolumes(ctx context.Context, id int, reqOpts ...RequestOption) ([]*Volume, error) {
+	e := fmt.Sprintf("%s/%d/volumes", instancesEndpoint, id)
+	policy := c.resolveRetryPolicy(reqOpts)
+
+	r, err := c.doWithRetry(ctx, policy, func() (*resty.Response, error) {
+		return c.R(ctx).
+			SetResult(&VolumesPagedResponse{}).
+			Get(e)
+	})
 	if err != nil {
 		return nil, err
 	}
-	resp, err := c.R().
-		SetResult(&VolumesPagedResponse{}).
-		Get(e)
-	if err != nil {
-		return nil, err
-	}
-	l := resp.Result().(*VolumesPagedResponse).Data
+
+	l := r.Result().(*VolumesPagedResponse).Data
 	for _, el := range l {
 		el.fixDates()
 	}
+
 	return l, nil
 }
 
@@ -0,0 +1,231 @@
+package linodego
+
+/**
+ * Lazy, page-at-a-time iteration over List endpoints, modeled after
+ * google.golang.org/api/iterator.
+ */
+
+import (
+	"context"
+	"errors"
+	"strconv"
+)
+
+// Done is returned by an iterator's Next method when the iteration is
+// complete; when Next returns Done, the *T result is nil.
+var Done = errors.New("no more items in iterator")
+
+// IteratorPageInfo exposes page-level details about the underlying request
+// for callers that want to correlate iterator progress with the raw API
+// response rather than simply consuming items one at a time.
+type IteratorPageInfo struct {
+	// Token is the current page number, reported as a string to mirror the
+	// token-based pagination idiom used by other SDKs.
+	Token string
+	// MaxSize is the number of items in the last page fetched.
+	MaxSize int
+}
+
+// pageFetcher retrieves a single page of results for a given ListOptions.
+// It is implemented per-resource (e.g. instances, volumes, events) and
+// wraps the resource's existing PagedResponse/listHelper plumbing.
+type pageFetcher[T any] func(ctx context.Context, opts *ListOptions) ([]T, error)
+
+// iterator lazily fetches one page at a time from a pageFetcher, buffering
+// its contents until Next() has to go back to the API for more.
+type iterator[T any] struct {
+	ctx     context.Context
+	fetch   pageFetcher[T]
+	opts    *ListOptions
+	buf     []T
+	page    int
+	pages   int
+	maxSize int
+	done    bool
+	started bool
+}
+
+func newIterator[T any](ctx context.Context, opts *ListOptions, fetch pageFetcher[T]) *iterator[T] {
+	if opts == nil {
+		opts = &ListOptions{}
+	}
+	if opts.PageOptions == nil {
+		opts.PageOptions = &PageOptions{}
+	}
+
+	return &iterator[T]{
+		ctx:   ctx,
+		fetch: fetch,
+		opts:  opts,
+	}
+}
+
+// next advances the iterator, fetching the next page on demand. It returns
+// Done once every page has been consumed.
+func (it *iterator[T]) next() (*T, error) {
+	for len(it.buf) == 0 {
+		if it.done {
+			return nil, Done
+		}
+
+		if err := it.advancePage(); err != nil {
+			return nil, err
+		}
+	}
+
+	item := it.buf[0]
+	it.buf = it.buf[1:]
+
+	return &item, nil
+}
+
+func (it *iterator[T]) advancePage() error {
+	if it.ctx.Err() != nil {
+		return it.ctx.Err()
+	}
+
+	nextPage := it.page + 1
+	opts := &ListOptions{
+		PageOptions: &PageOptions{Page: nextPage},
+		PageSize:    it.opts.PageSize,
+		Filter:      it.opts.Filter,
+		QueryParams: it.opts.QueryParams,
+	}
+
+	data, err := it.fetch(it.ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	it.page = opts.Page
+	it.pages = opts.Pages
+	it.buf = data
+	it.maxSize = len(data)
+
+	if it.pages == 0 || it.page >= it.pages {
+		it.done = true
+	}
+
+	return nil
+}
+
+// pageInfo reports the page currently buffered by the iterator.
+func (it *iterator[T]) pageInfo() *IteratorPageInfo {
+	return &IteratorPageInfo{
+		Token:   strconv.Itoa(it.page),
+		MaxSize: it.maxSize,
+	}
+}
+
+// InstanceIterator streams Instances one at a time, fetching pages from the
+// API lazily as Next is called. It is returned by Client.NewInstanceIterator.
+type InstanceIterator struct {
+	it *iterator[Instance]
+}
+
+// NewInstanceIterator returns an InstanceIterator over the account's Linode
+// instances matching opts. Unlike ListInstances, no page is fetched until
+// Next is first called, and callers may stop iterating (e.g. on finding a
+// match) without paying for pages they never consume.
+func (c *Client) NewInstanceIterator(ctx context.Context, opts *ListOptions) *InstanceIterator {
+	return &InstanceIterator{
+		it: newIterator(ctx, opts, func(ctx context.Context, opts *ListOptions) ([]Instance, error) {
+			page := InstancesPagedResponse{}
+			if err := c.listHelper(ctx, &page, opts, nil); err != nil {
+				return nil, err
+			}
+
+			out := make([]Instance, len(page.Data))
+			for i, instance := range page.Data {
+				out[i] = *instance
+			}
+
+			return out, nil
+		}),
+	}
+}
+
+// Next returns the next Instance in the iteration, or Done once every page
+// has been exhausted.
+func (it *InstanceIterator) Next() (*Instance, error) {
+	return it.it.next()
+}
+
+// PageInfo reports the page currently buffered by the iterator.
+func (it *InstanceIterator) PageInfo() *IteratorPageInfo {
+	return it.it.pageInfo()
+}
+
+// VolumeIterator streams Volumes one at a time, fetching pages from the API
+// lazily as Next is called. It is returned by Client.NewVolumeIterator.
+type VolumeIterator struct {
+	it *iterator[Volume]
+}
+
+// NewVolumeIterator returns a VolumeIterator over the account's volumes
+// matching opts.
+func (c *Client) NewVolumeIterator(ctx context.Context, opts *ListOptions) *VolumeIterator {
+	return &VolumeIterator{
+		it: newIterator(ctx, opts, func(ctx context.Context, opts *ListOptions) ([]Volume, error) {
+			page := VolumesPagedResponse{}
+			if err := c.listHelper(ctx, &page, opts, nil); err != nil {
+				return nil, err
+			}
+
+			out := make([]Volume, len(page.Data))
+			for i, volume := range page.Data {
+				out[i] = *volume
+			}
+
+			return out, nil
+		}),
+	}
+}
+
+// Next returns the next Volume in the iteration, or Done once every page has
+// been exhausted.
+func (it *VolumeIterator) Next() (*Volume, error) {
+	return it.it.next()
+}
+
+// PageInfo reports the page currently buffered by the iterator.
+func (it *VolumeIterator) PageInfo() *IteratorPageInfo {
+	return it.it.pageInfo()
+}
+
+// EventIterator streams Events one at a time, fetching pages from the API
+// lazily as Next is called. It is returned by Client.NewEventIterator.
+type EventIterator struct {
+	it *iterator[Event]
+}
+
+// NewEventIterator returns an EventIterator over the account's events
+// matching opts.
+func (c *Client) NewEventIterator(ctx context.Context, opts *ListOptions) *EventIterator {
+	return &EventIterator{
+		it: newIterator(ctx, opts, func(ctx context.Context, opts *ListOptions) ([]Event, error) {
+			page := EventsPagedResponse{}
+			if err := c.listHelper(ctx, &page, opts, nil); err != nil {
+				return nil, err
+			}
+
+			out := make([]Event, len(page.Data))
+			for i, event := range page.Data {
+				out[i] = *event
+			}
+
+			return out, nil
+		}),
+	}
+}
+
+// Next returns the next Event in the iteration, or Done once every page has
+// been exhausted.
+func (it *EventIterator) Next() (*Event, error) {
+	return it.it.next()
+}
+
+// PageInfo reports the page currently buffered by the iterator.
+func (it *EventIterator) PageInfo() *IteratorPageInfo {
+	return it.it.pageInfo()
+}
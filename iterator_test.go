@@ -0,0 +1,124 @@
+package linodego
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// pagedFetcher returns a pageFetcher serving pages out of data, page sized,
+// and recording the total page count it reports back via opts.Pages for
+// newIterator's caller to observe.
+func pagedFetcher(data []int, pageSize int) pageFetcher[int] {
+	return func(_ context.Context, opts *ListOptions) ([]int, error) {
+		totalPages := (len(data) + pageSize - 1) / pageSize
+		if totalPages == 0 {
+			totalPages = 1
+		}
+
+		start := (opts.Page - 1) * pageSize
+		if start >= len(data) {
+			opts.Pages = totalPages
+			return nil, nil
+		}
+
+		end := start + pageSize
+		if end > len(data) {
+			end = len(data)
+		}
+
+		opts.Pages = totalPages
+		return data[start:end], nil
+	}
+}
+
+func TestIteratorNextDrainsAllPages(t *testing.T) {
+	it := newIterator(context.Background(), nil, pagedFetcher([]int{1, 2, 3, 4, 5}, 2))
+
+	var got []int
+	for {
+		v, err := it.next()
+		if errors.Is(err, Done) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("next() error = %v", err)
+		}
+		got = append(got, *v)
+	}
+
+	want := []int{1, 2, 3, 4, 5}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestIteratorEmptyResultIsImmediatelyDone(t *testing.T) {
+	it := newIterator(context.Background(), nil, pagedFetcher(nil, 2))
+
+	if _, err := it.next(); !errors.Is(err, Done) {
+		t.Fatalf("next() error = %v, want Done", err)
+	}
+}
+
+func TestIteratorPropagatesFetchError(t *testing.T) {
+	wantErr := errors.New("boom")
+	it := newIterator(context.Background(), nil, func(context.Context, *ListOptions) ([]int, error) {
+		return nil, wantErr
+	})
+
+	if _, err := it.next(); !errors.Is(err, wantErr) {
+		t.Fatalf("next() error = %v, want %v", err, wantErr)
+	}
+}
+
+func TestIteratorRespectsCanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	it := newIterator(ctx, nil, pagedFetcher([]int{1}, 2))
+
+	if _, err := it.next(); err == nil {
+		t.Fatal("next() error = nil, want context.Canceled")
+	}
+}
+
+func TestIteratorPageInfoReportsCurrentPage(t *testing.T) {
+	it := newIterator(context.Background(), nil, pagedFetcher([]int{1, 2, 3}, 2))
+
+	if _, err := it.next(); err != nil {
+		t.Fatalf("next() error = %v", err)
+	}
+
+	info := it.pageInfo()
+	if info.Token != "1" {
+		t.Errorf("pageInfo().Token = %q, want %q", info.Token, "1")
+	}
+}
+
+func TestIteratorPageInfoMaxSizeIsStableWithinAPage(t *testing.T) {
+	it := newIterator(context.Background(), nil, pagedFetcher([]int{1, 2, 3}, 2))
+
+	if _, err := it.next(); err != nil {
+		t.Fatalf("next() error = %v", err)
+	}
+
+	before := it.pageInfo().MaxSize
+	if before != 2 {
+		t.Fatalf("pageInfo().MaxSize = %d, want %d", before, 2)
+	}
+
+	if _, err := it.next(); err != nil {
+		t.Fatalf("next() error = %v", err)
+	}
+
+	after := it.pageInfo().MaxSize
+	if after != before {
+		t.Errorf("pageInfo().MaxSize changed within the same page: got %d, want %d", after, before)
+	}
+}
@@ -0,0 +1,254 @@
+// Package metadata is a client for the Linode Metadata Service, an
+// in-guest HTTP endpoint (analogous to EC2's IMDSv2) that StackScripts,
+// cloud-init hooks, and other in-instance agents can use to read facts
+// about the instance they're running on without an API token.
+package metadata
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/linode/linodego"
+)
+
+const (
+	// baseURL is the well-known, link-local address of the metadata
+	// service; it is not configurable, since the service only ever
+	// listens there.
+	baseURL = "http://169.254.169.254/v1"
+
+	// tokenTTL is requested on every token acquisition/refresh.
+	tokenTTL = 1 * time.Hour
+
+	// refreshSkew is how far ahead of expiry a cached token is renewed.
+	refreshSkew = 60 * time.Second
+
+	tokenHeader    = "Metadata-Token"
+	tokenTTLHeader = "Metadata-Token-Expiry-Seconds"
+)
+
+// Client talks to the Linode Metadata Service. It acquires and transparently
+// refreshes its own token, so callers never handle one directly.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// NewClient returns a metadata Client. The returned client refuses to
+// follow redirects and will only ever send requests (and the token header)
+// to the metadata service's link-local address, so a misbehaving or
+// spoofed redirect can't exfiltrate the token to another host.
+func NewClient() *Client {
+	return &Client{
+		httpClient: &http.Client{
+			Timeout:       10 * time.Second,
+			CheckRedirect: refuseRedirect,
+		},
+		baseURL: baseURL,
+	}
+}
+
+func refuseRedirect(*http.Request, []*http.Request) error {
+	return errors.New("metadata: refusing to follow redirect")
+}
+
+// InstanceData describes the instance the Metadata Service is running on.
+// Fields mirror linodego.Instance where the shapes overlap; Specs reuses
+// linodego.InstanceSpec directly for the nested "specs" object.
+type InstanceData struct {
+	ID       int                   `json:"id"`
+	Label    string                `json:"label"`
+	Region   string                `json:"region"`
+	Type     string                `json:"type"`
+	Image    string                `json:"image"`
+	HostUUID string                `json:"host_uuid"`
+	Tags     []string              `json:"tags"`
+	Specs    linodego.InstanceSpec `json:"specs"`
+}
+
+// NetworkData describes the instance's network interfaces.
+type NetworkData struct {
+	IPv4 struct {
+		Public  []string `json:"public"`
+		Private []string `json:"private"`
+		Shared  []string `json:"shared"`
+	} `json:"ipv4"`
+	IPv6 struct {
+		SLAAC     string   `json:"slaac"`
+		LinkLocal string   `json:"link_local"`
+		Ranges    []string `json:"ranges"`
+	} `json:"ipv6"`
+	Interfaces []NetworkInterface `json:"interfaces"`
+}
+
+// NetworkInterface describes a single network interface attached to the
+// instance.
+type NetworkInterface struct {
+	Label       string `json:"label"`
+	Purpose     string `json:"purpose"`
+	IPAMAddress string `json:"ipam_address"`
+}
+
+// RegionData describes the region the instance is running in.
+type RegionData struct {
+	Region          string   `json:"region"`
+	PlacementGroups []string `json:"placement_groups,omitempty"`
+}
+
+// Instance returns the metadata service's view of the running instance.
+func (c *Client) Instance(ctx context.Context) (*InstanceData, error) {
+	var out InstanceData
+	if err := c.getJSON(ctx, "/instance", &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// Network returns the instance's network configuration.
+func (c *Client) Network(ctx context.Context) (*NetworkData, error) {
+	var out NetworkData
+	if err := c.getJSON(ctx, "/network", &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// SSHKeys returns the SSH public keys authorized for the instance.
+func (c *Client) SSHKeys(ctx context.Context) ([]string, error) {
+	var out struct {
+		Users map[string][]string `json:"users"`
+	}
+	if err := c.getJSON(ctx, "/ssh-keys", &out); err != nil {
+		return nil, err
+	}
+
+	var keys []string
+	for _, userKeys := range out.Users {
+		keys = append(keys, userKeys...)
+	}
+
+	return keys, nil
+}
+
+// UserData returns the instance's user-data, base64-decoded. It returns an
+// empty string if no user-data was supplied at creation time.
+func (c *Client) UserData(ctx context.Context) (string, error) {
+	body, err := c.get(ctx, "/user-data")
+	if err != nil {
+		return "", err
+	}
+
+	if len(body) == 0 {
+		return "", nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(string(body))
+	if err != nil {
+		return "", fmt.Errorf("metadata: decoding user-data: %w", err)
+	}
+
+	return string(decoded), nil
+}
+
+// RegionInfo returns metadata about the instance's region.
+func (c *Client) RegionInfo(ctx context.Context) (*RegionData, error) {
+	var out RegionData
+	if err := c.getJSON(ctx, "/region", &out); err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+func (c *Client) getJSON(ctx context.Context, path string, out any) error {
+	body, err := c.get(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("metadata: decoding response from %s: %w", path, err)
+	}
+
+	return nil
+}
+
+func (c *Client) get(ctx context.Context, path string) ([]byte, error) {
+	token, err := c.getToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("metadata: acquiring token: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set(tokenHeader, token)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("metadata: %s %s: unexpected status %d", http.MethodGet, path, resp.StatusCode)
+	}
+
+	return body, nil
+}
+
+// getToken returns a cached token if it has more than refreshSkew left
+// before expiry, refreshing it from the service otherwise.
+func (c *Client) getToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && time.Until(c.expiresAt) > refreshSkew {
+		return c.token, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, c.baseURL+"/token", nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set(tokenTTLHeader, fmt.Sprintf("%d", int(tokenTTL.Seconds())))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("PUT /token: unexpected status %d", resp.StatusCode)
+	}
+
+	c.token = string(body)
+	c.expiresAt = time.Now().Add(tokenTTL)
+
+	return c.token, nil
+}
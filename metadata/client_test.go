@@ -0,0 +1,134 @@
+package metadata
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	return &Client{
+		httpClient: srv.Client(),
+		baseURL:    srv.URL,
+	}
+}
+
+func TestGetTokenAcquiresAndCaches(t *testing.T) {
+	var puts int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			atomic.AddInt32(&puts, 1)
+			w.Write([]byte("tok-1"))
+			return
+		}
+		http.NotFound(w, r)
+	})
+
+	tok, err := c.getToken(context.Background())
+	if err != nil {
+		t.Fatalf("getToken() error = %v", err)
+	}
+	if tok != "tok-1" {
+		t.Errorf("getToken() = %q, want %q", tok, "tok-1")
+	}
+
+	if _, err := c.getToken(context.Background()); err != nil {
+		t.Fatalf("getToken() (cached) error = %v", err)
+	}
+
+	if got := atomic.LoadInt32(&puts); got != 1 {
+		t.Errorf("PUT /token called %d times, want 1 (second call should hit the cache)", got)
+	}
+}
+
+func TestGetTokenRefreshesNearExpiry(t *testing.T) {
+	var puts int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			n := atomic.AddInt32(&puts, 1)
+			if n == 1 {
+				w.Write([]byte("tok-1"))
+			} else {
+				w.Write([]byte("tok-2"))
+			}
+			return
+		}
+		http.NotFound(w, r)
+	})
+
+	if _, err := c.getToken(context.Background()); err != nil {
+		t.Fatalf("getToken() error = %v", err)
+	}
+
+	// Force the cached token to look like it's within refreshSkew of expiry.
+	c.expiresAt = time.Now().Add(refreshSkew / 2)
+
+	tok, err := c.getToken(context.Background())
+	if err != nil {
+		t.Fatalf("getToken() (refresh) error = %v", err)
+	}
+	if tok != "tok-2" {
+		t.Errorf("getToken() (refresh) = %q, want %q", tok, "tok-2")
+	}
+	if got := atomic.LoadInt32(&puts); got != 2 {
+		t.Errorf("PUT /token called %d times, want 2", got)
+	}
+}
+
+func TestGetTokenErrorsOnNonOKStatus(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	if _, err := c.getToken(context.Background()); err == nil {
+		t.Fatal("getToken() error = nil, want non-nil on a 500 response")
+	}
+}
+
+func TestInstanceDecodesResponse(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/token":
+			w.Write([]byte("tok-1"))
+		case "/instance":
+			w.Write([]byte(`{
+				"id": 123,
+				"label": "web-1",
+				"region": "us-east",
+				"specs": {
+					"disk": 81920,
+					"memory": 4096,
+					"vcpus": 2,
+					"transfer": 4000
+				}
+			}`))
+		default:
+			http.NotFound(w, r)
+		}
+	})
+
+	inst, err := c.Instance(context.Background())
+	if err != nil {
+		t.Fatalf("Instance() error = %v", err)
+	}
+	if inst.ID != 123 || inst.Label != "web-1" || inst.Region != "us-east" {
+		t.Errorf("Instance() = %+v, want ID=123 Label=web-1 Region=us-east", inst)
+	}
+	if inst.Specs.Disk != 81920 || inst.Specs.Memory != 4096 || inst.Specs.VCPUs != 2 || inst.Specs.Transfer != 4000 {
+		t.Errorf("Instance().Specs = %+v, want Disk=81920 Memory=4096 VCPUs=2 Transfer=4000", inst.Specs)
+	}
+}
+
+func TestRefuseRedirect(t *testing.T) {
+	if err := refuseRedirect(nil, nil); err == nil {
+		t.Fatal("refuseRedirect() error = nil, want non-nil")
+	}
+}
@@ -87,24 +87,36 @@ func applyListOptionsToRequest(opts *ListOptions, req *resty.Request) error {
 
 type PagedResponse interface {
 	endpoint(...any) string
-	castResult(*resty.Request, string) (int, int, error)
+	castResult(*resty.Request, string) (*resty.Response, int, int, error)
 }
 
 // listHelper abstracts fetching and pagination for GET endpoints that
 // do not require any Ids (top level endpoints).
 // When opts (or opts.Page) is nil, all pages will be fetched and
 // returned in a single (endpoint-specific)PagedResponse
-// opts.results and opts.pages will be updated from the API response
-func (c *Client) listHelper(ctx context.Context, pager PagedResponse, opts *ListOptions, ids ...any) error {
-	req := c.R(ctx)
-	if err := applyListOptionsToRequest(opts, req); err != nil {
-		return err
-	}
+// opts.results and opts.pages will be updated from the API response.
+// reqOpts carries per-call overrides (e.g. WithRetryPolicy) down into the
+// retry loop guarding each page fetch.
+func (c *Client) listHelper(ctx context.Context, pager PagedResponse, opts *ListOptions, reqOpts []RequestOption, ids ...any) error {
+	policy := c.resolveRetryPolicy(reqOpts)
+	endpoint := pager.endpoint(ids...)
+
+	var pages, results int
+	_, err := c.doWithRetry(ctx, policy, func() (*resty.Response, error) {
+		req := c.R(ctx)
+		if err := applyListOptionsToRequest(opts, req); err != nil {
+			return nil, err
+		}
+
+		resp, p, r, castErr := pager.castResult(req, endpoint)
+		pages, results = p, r
 
-	pages, results, err := pager.castResult(req, pager.endpoint(ids...))
+		return resp, castErr
+	})
 	if err != nil {
 		return err
 	}
+
 	if opts == nil {
 		opts = &ListOptions{PageOptions: &PageOptions{Page: 0}}
 	}
@@ -114,7 +126,7 @@ func (c *Client) listHelper(ctx context.Context, pager PagedResponse, opts *List
 	if opts.Page == 0 {
 		for page := 2; page <= pages; page++ {
 			opts.Page = page
-			if err := c.listHelper(ctx, pager, opts, ids...); err != nil {
+			if err := c.listHelper(ctx, pager, opts, reqOpts, ids...); err != nil {
 				return err
 			}
 		}
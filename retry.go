@@ -0,0 +1,195 @@
+package linodego
+
+/**
+ * Retry policy configuration for transient request failures.
+ */
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// RetryPolicy configures how the Client retries a request that failed for a
+// transient reason (rate limiting, a 5xx response, or a network error).
+// Delays between attempts grow exponentially from Initial towards Max
+// (scaled by Multiplier each attempt), with jitter added to avoid
+// synchronized retries across callers.
+type RetryPolicy struct {
+	// Initial is the delay before the first retry.
+	Initial time.Duration
+	// Max is the ceiling the backoff delay will not exceed.
+	Max time.Duration
+	// Multiplier scales the delay after each attempt, e.g. 2 doubles it.
+	Multiplier float64
+	// MaxAttempts is the maximum number of retries (not counting the
+	// original request) before giving up.
+	MaxAttempts int
+	// ShouldRetry decides whether a given response/error pair is retryable.
+	// If nil, DefaultShouldRetry is used.
+	ShouldRetry func(resp *resty.Response, err error) bool
+}
+
+// DefaultRetryPolicy is applied to a Client unless overridden via
+// Client.SetRetryPolicy.
+var DefaultRetryPolicy = RetryPolicy{
+	Initial:     time.Second,
+	Max:         time.Minute,
+	Multiplier:  2,
+	MaxAttempts: 5,
+	ShouldRetry: DefaultShouldRetry,
+}
+
+// DefaultShouldRetry retries on HTTP 429 and 502/503/504 responses, and on
+// errors that report themselves as timeouts (a Timeout() method returning
+// true, as network and deadline errors do). Other errors, such as
+// connection resets, are not retried.
+func DefaultShouldRetry(resp *resty.Response, err error) bool {
+	if err != nil {
+		return isTransientNetworkError(err)
+	}
+
+	switch resp.StatusCode() {
+	case http.StatusTooManyRequests,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// SetRetryPolicy sets the RetryPolicy every request issued by the Client
+// uses unless a call overrides it with WithRetryPolicy. Body replay for
+// POST/PUT is safe because request bodies are marshaled to a string up
+// front (see CreateInstance, et al.) rather than streamed from a Reader.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) *Client {
+	if policy.ShouldRetry == nil {
+		policy.ShouldRetry = DefaultShouldRetry
+	}
+	if policy.Multiplier <= 0 {
+		policy.Multiplier = 1
+	}
+
+	c.retryPolicy = policy
+
+	return c
+}
+
+// requestOptions holds per-call overrides applied on top of the Client's
+// default configuration.
+type requestOptions struct {
+	retryPolicy *RetryPolicy
+}
+
+// RequestOption customizes the behavior of a single API call, e.g.
+// WithRetryPolicy(customPolicy).
+type RequestOption func(*requestOptions)
+
+// WithRetryPolicy overrides the Client's RetryPolicy for a single call.
+func WithRetryPolicy(policy RetryPolicy) RequestOption {
+	return func(o *requestOptions) {
+		o.retryPolicy = &policy
+	}
+}
+
+// resolveRetryPolicy returns the RetryPolicy a call should use: the
+// per-call override from opts if one was given, c.retryPolicy otherwise.
+func (c *Client) resolveRetryPolicy(opts []RequestOption) RetryPolicy {
+	o := &requestOptions{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	if o.retryPolicy == nil {
+		return c.retryPolicy
+	}
+
+	policy := *o.retryPolicy
+	if policy.ShouldRetry == nil {
+		policy.ShouldRetry = DefaultShouldRetry
+	}
+	if policy.Multiplier <= 0 {
+		policy.Multiplier = 1
+	}
+
+	return policy
+}
+
+// doWithRetry runs fn, retrying per policy until it succeeds, policy's
+// ShouldRetry declines to retry, MaxAttempts is exhausted, or ctx is done.
+// fn is expected to build and send a single resty.Request per call (resty
+// Requests are single-use).
+func (c *Client) doWithRetry(ctx context.Context, policy RetryPolicy, fn func() (*resty.Response, error)) (*resty.Response, error) {
+	delay := policy.Initial
+
+	for attempt := 0; ; attempt++ {
+		resp, err := fn()
+
+		if attempt >= policy.MaxAttempts || !policy.ShouldRetry(resp, err) {
+			return resp, err
+		}
+
+		wait := retryAfter(resp)
+		if wait == 0 {
+			wait = jitter(delay)
+		}
+
+		select {
+		case <-ctx.Done():
+			return resp, ctx.Err()
+		case <-time.After(wait):
+		}
+
+		delay = time.Duration(float64(delay) * policy.Multiplier)
+		if delay > policy.Max {
+			delay = policy.Max
+		}
+	}
+}
+
+// retryAfter honors the Retry-After header verbatim, returning 0 if resp is
+// nil or the header is absent/malformed so the caller falls back to its own
+// backoff delay.
+func retryAfter(resp *resty.Response) time.Duration {
+	if resp == nil {
+		return 0
+	}
+
+	ra := resp.Header().Get("Retry-After")
+	if ra == "" {
+		return 0
+	}
+
+	if seconds, err := time.ParseDuration(ra + "s"); err == nil {
+		return seconds
+	}
+
+	return 0
+}
+
+// jitter adds up to 20% random variance to a backoff delay so that many
+// clients retrying at once don't land on the API in lockstep.
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return d
+	}
+
+	return d + time.Duration(rand.Int63n(int64(d)/5+1))
+}
+
+func isTransientNetworkError(err error) bool {
+	type timeout interface {
+		Timeout() bool
+	}
+
+	if t, ok := err.(timeout); ok {
+		return t.Timeout()
+	}
+
+	return false
+}
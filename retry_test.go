@@ -0,0 +1,117 @@
+package linodego
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestRetryAfter(t *testing.T) {
+	cases := []struct {
+		name   string
+		header string
+		want   time.Duration
+	}{
+		{"absent", "", 0},
+		{"seconds", "5", 5 * time.Second},
+		{"malformed", "soon", 0},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &resty.Response{
+				RawResponse: &http.Response{Header: http.Header{}},
+			}
+			if tt.header != "" {
+				resp.RawResponse.Header.Set("Retry-After", tt.header)
+			}
+
+			if got := retryAfter(resp); got != tt.want {
+				t.Errorf("retryAfter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := retryAfter(nil); got != 0 {
+		t.Errorf("retryAfter(nil) = %v, want 0", got)
+	}
+}
+
+func TestJitter(t *testing.T) {
+	if got := jitter(0); got != 0 {
+		t.Errorf("jitter(0) = %v, want 0", got)
+	}
+
+	d := time.Second
+	for i := 0; i < 20; i++ {
+		got := jitter(d)
+		if got < d || got > d+d/5 {
+			t.Fatalf("jitter(%v) = %v, want within [%v, %v]", d, got, d, d+d/5)
+		}
+	}
+}
+
+func TestResolveRetryPolicyDefaultsToClient(t *testing.T) {
+	c := &Client{retryPolicy: DefaultRetryPolicy}
+
+	got := c.resolveRetryPolicy(nil)
+	if got.MaxAttempts != DefaultRetryPolicy.MaxAttempts {
+		t.Errorf("resolveRetryPolicy(nil).MaxAttempts = %d, want %d", got.MaxAttempts, DefaultRetryPolicy.MaxAttempts)
+	}
+}
+
+func TestResolveRetryPolicyOverride(t *testing.T) {
+	c := &Client{retryPolicy: DefaultRetryPolicy}
+
+	override := RetryPolicy{Initial: time.Millisecond, Max: time.Second, Multiplier: 3, MaxAttempts: 1}
+	got := c.resolveRetryPolicy([]RequestOption{WithRetryPolicy(override)})
+
+	if got.MaxAttempts != 1 {
+		t.Errorf("resolveRetryPolicy override MaxAttempts = %d, want 1", got.MaxAttempts)
+	}
+	if got.Multiplier != 3 {
+		t.Errorf("resolveRetryPolicy override Multiplier = %v, want 3", got.Multiplier)
+	}
+	if got.ShouldRetry == nil {
+		t.Error("resolveRetryPolicy override ShouldRetry = nil, want DefaultShouldRetry fallback")
+	}
+}
+
+func TestSetRetryPolicyAppliesDefaults(t *testing.T) {
+	c := &Client{}
+	c.SetRetryPolicy(RetryPolicy{Initial: time.Second, Max: time.Minute, MaxAttempts: 2})
+
+	if c.retryPolicy.ShouldRetry == nil {
+		t.Error("SetRetryPolicy left ShouldRetry nil, want DefaultShouldRetry")
+	}
+	if c.retryPolicy.Multiplier != 1 {
+		t.Errorf("SetRetryPolicy left Multiplier = %v, want 1 for a non-positive input", c.retryPolicy.Multiplier)
+	}
+}
+
+func TestDoWithRetryStopsOnMaxAttempts(t *testing.T) {
+	policy := RetryPolicy{
+		Initial:     time.Millisecond,
+		Max:         time.Millisecond,
+		Multiplier:  1,
+		MaxAttempts: 2,
+		ShouldRetry: func(*resty.Response, error) bool { return true },
+	}
+
+	c := &Client{}
+	calls := 0
+	_, err := c.doWithRetry(context.Background(), policy, func() (*resty.Response, error) {
+		calls++
+		return nil, http.ErrHandlerTimeout
+	})
+
+	if calls != 3 {
+		t.Errorf("doWithRetry made %d calls, want 3 (1 original + 2 retries)", calls)
+	}
+	if err != http.ErrHandlerTimeout {
+		t.Errorf("doWithRetry err = %v, want %v", err, http.ErrHandlerTimeout)
+	}
+}
@@ -0,0 +1,74 @@
+package linodego
+
+/*
+ * https://developers.linode.com/v4/reference/endpoints/volumes
+ */
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const volumesEndpoint = "volumes"
+
+// Volume represents a Linode block storage volume
+type Volume struct {
+	CreatedStr string `json:"created"`
+	UpdatedStr string `json:"updated"`
+
+	ID             int
+	Created        *time.Time `json:"-"`
+	Updated        *time.Time `json:"-"`
+	Label          string
+	Status         string
+	Region         string
+	Size           int
+	LinodeID       *int
+	FilesystemPath string
+	Tags           []string
+}
+
+func (v *Volume) fixDates() *Volume {
+	v.Created, _ = parseDates(v.CreatedStr)
+	v.Updated, _ = parseDates(v.UpdatedStr)
+	return v
+}
+
+// VolumesPagedResponse represents a Linode API response for listing volumes
+type VolumesPagedResponse struct {
+	*PageOptions
+	Data []*Volume
+}
+
+func (VolumesPagedResponse) endpoint(_ ...any) string {
+	return volumesEndpoint
+}
+
+func (resp *VolumesPagedResponse) castResult(req *resty.Request, e string) (*resty.Response, int, int, error) {
+	r, err := req.SetResult(&VolumesPagedResponse{}).Get(e)
+	if err != nil {
+		return r, 0, 0, err
+	}
+
+	result := r.Result().(*VolumesPagedResponse)
+	for _, volume := range result.Data {
+		volume.fixDates()
+	}
+
+	resp.Data = append(resp.Data, result.Data...)
+
+	return r, result.Pages, result.Results, nil
+}
+
+// ListVolumes lists block storage volumes. opts may be nil to fetch every
+// page.
+func (c *Client) ListVolumes(ctx context.Context, opts *ListOptions, reqOpts ...RequestOption) ([]*Volume, error) {
+	response := VolumesPagedResponse{}
+	if err := c.listHelper(ctx, &response, opts, reqOpts); err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
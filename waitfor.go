@@ -0,0 +1,218 @@
+package linodego
+
+/**
+ * Polling helpers that block until an async instance operation has
+ * actually finished, correlating the triggering action to the event
+ * that reports its completion.
+ */
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// InstanceStatus constants used by WaitForInstanceStatus. These mirror the
+// `status` values returned for a Linode instance.
+type InstanceStatus string
+
+const (
+	InstanceRunning      InstanceStatus = "running"
+	InstanceOffline      InstanceStatus = "offline"
+	InstanceBooting      InstanceStatus = "booting"
+	InstanceRebooting    InstanceStatus = "rebooting"
+	InstanceShuttingDown InstanceStatus = "shutting_down"
+	InstanceProvisioning InstanceStatus = "provisioning"
+	InstanceMigrating    InstanceStatus = "migrating"
+	InstanceRebuilding   InstanceStatus = "rebuilding"
+	InstanceCloning      InstanceStatus = "cloning"
+	InstanceRestoring    InstanceStatus = "restoring"
+	InstanceResizing     InstanceStatus = "resizing"
+)
+
+// WaitForTimeoutError is returned by the WaitFor* helpers when timeout
+// elapses (or the parent context is done) between polls, before the desired
+// condition is observed. If the deadline passes while a poll request is in
+// flight, that request's error (e.g. context.DeadlineExceeded) is returned
+// as is instead.
+type WaitForTimeoutError struct {
+	// Condition describes what was being waited for, e.g. "instance 123 to
+	// reach status running".
+	Condition string
+	// LastError is the most recent polling error, if any.
+	LastError error
+}
+
+func (e WaitForTimeoutError) Error() string {
+	if e.LastError != nil {
+		return fmt.Sprintf("timed out waiting for %s: %s", e.Condition, e.LastError)
+	}
+
+	return fmt.Sprintf("timed out waiting for %s", e.Condition)
+}
+
+func (e WaitForTimeoutError) Unwrap() error {
+	return e.LastError
+}
+
+// pollBackoff is the bounded exponential backoff used between polls of the
+// instance and event endpoints. It starts fast, since most actions finish
+// within a few seconds, and backs off so long waits don't hammer the API.
+type pollBackoff struct {
+	delay time.Duration
+	max   time.Duration
+}
+
+func newPollBackoff() *pollBackoff {
+	return &pollBackoff{delay: 500 * time.Millisecond, max: 15 * time.Second}
+}
+
+func (b *pollBackoff) next() time.Duration {
+	d := b.delay
+	b.delay *= 2
+	if b.delay > b.max {
+		b.delay = b.max
+	}
+
+	return d
+}
+
+// WaitForInstanceStatus polls the instance until it reaches target, timeout
+// elapses, or ctx is done, whichever happens first.
+func (c *Client) WaitForInstanceStatus(ctx context.Context, linodeID int, target InstanceStatus, timeout time.Duration) (*Instance, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	backoff := newPollBackoff()
+
+	for {
+		instance, err := c.GetInstance(ctx, linodeID)
+		if err != nil {
+			return nil, err
+		}
+
+		if InstanceStatus(instance.Status) == target {
+			return instance, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, WaitForTimeoutError{
+				Condition: fmt.Sprintf("instance %d to reach status %s (currently %s)", linodeID, target, instance.Status),
+				LastError: ctx.Err(),
+			}
+		case <-time.After(backoff.next()):
+		}
+	}
+}
+
+// WaitForEventFinished polls /account/events for the event matching
+// entityID/entityType/action, correlating it to the operation that just
+// triggered it using the event's entity ID, action, and created timestamp
+// (so a second, unrelated action of the same type on the same entity isn't
+// mistaken for the one being waited on). It returns once the event's status
+// is "finished", returns an error if the event reports "failed", and times
+// out otherwise.
+func (c *Client) WaitForEventFinished(ctx context.Context, entityID int, entityType EntityType, action EventAction, minStart time.Time, timeout time.Duration) (*Event, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	backoff := newPollBackoff()
+	condition := fmt.Sprintf("event %s on %s %d to finish", action, entityType, entityID)
+
+	for {
+		event, err := c.findMatchingEvent(ctx, entityID, entityType, action, minStart)
+		if err != nil {
+			return nil, err
+		}
+
+		if event != nil {
+			switch event.Status {
+			case EventFinished:
+				return event, nil
+			case EventFailed:
+				return nil, fmt.Errorf("%s: action failed", condition)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, WaitForTimeoutError{Condition: condition, LastError: ctx.Err()}
+		case <-time.After(backoff.next()):
+		}
+	}
+}
+
+// findMatchingEvent scopes the /account/events query to the entity being
+// waited on, rather than walking the account's entire event history: it
+// filters on entity.id/entity.type (mirroring the tag-based filter discover
+// uses against ListInstances) and bounds the result to the most recent page,
+// since the event being waited for is always among the newest.
+func (c *Client) findMatchingEvent(ctx context.Context, entityID int, entityType EntityType, action EventAction, minStart time.Time) (*Event, error) {
+	filter, err := json.Marshal(map[string]any{
+		"entity.id":   entityID,
+		"entity.type": entityType,
+		"+order_by":   "created",
+		"+order":      "desc",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := c.ListEvents(ctx, &ListOptions{
+		PageOptions: &PageOptions{Page: 1},
+		PageSize:    100,
+		Filter:      string(filter),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, event := range events {
+		if event.Action != action {
+			continue
+		}
+		if event.Entity == nil || event.Entity.Type != entityType || event.Entity.ID != entityID {
+			continue
+		}
+		// Event timestamps have whole-second precision, so compare against
+		// minStart truncated to the second.
+		if event.Created != nil && event.Created.Before(minStart.Truncate(time.Second)) {
+			continue
+		}
+
+		return event, nil
+	}
+
+	return nil, nil
+}
+
+// WaitForInstanceDiskStatus polls the instance's disk until it reaches
+// target, timeout elapses, or ctx is done.
+func (c *Client) WaitForInstanceDiskStatus(ctx context.Context, linodeID, diskID int, target DiskStatus, timeout time.Duration) (*InstanceDisk, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	backoff := newPollBackoff()
+
+	for {
+		disk, err := c.GetInstanceDisk(ctx, linodeID, diskID)
+		if err != nil {
+			return nil, err
+		}
+
+		if disk.Status == target {
+			return disk, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, WaitForTimeoutError{
+				Condition: fmt.Sprintf("disk %d on instance %d to reach status %s (currently %s)", diskID, linodeID, target, disk.Status),
+				LastError: ctx.Err(),
+			}
+		case <-time.After(backoff.next()):
+		}
+	}
+}